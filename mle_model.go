@@ -0,0 +1,176 @@
+package autoregression
+
+import (
+    "math"
+    "math/cmplx"
+    "gonum.org/v1/gonum/diff/fd"
+    "gonum.org/v1/gonum/mat"
+    "gonum.org/v1/gonum/optimize"
+)
+
+// NewModelMLE fits an AR(p) model by maximizing the exact Gaussian
+// log-likelihood of all n observations under a stationary AR(p) process,
+// rather than conditioning on the first order observations the way
+// NewModelOLS does. It is parameterized by (phi_1..phi_order, ln(sigma^2)) -
+// the log keeps the innovation variance positive without constraining the
+// optimizer - and minimizes the negative log-likelihood with
+// gonum.org/v1/gonum/optimize's BFGS method, approximating the gradient with
+// fd.Gradient and seeding the search from NewModelYuleWalker's estimate.
+// Candidate parameters whose implied process is non-stationary are
+// penalized with +Inf rather than evaluated; NewModelMLE itself returns
+// ErrNonStationary if the optimizer still converges outside the stationary
+// region. MLE tends to outperform OLS on short series at the cost of a
+// non-convex, iterative fit.
+func NewModelMLE(data []float64, order int, settings *optimize.Settings) (*ARModel, error) {
+    if order < 0 {
+        return nil, ErrNegativeOrder
+    }
+    if len(data) <= 2 * order + 1 {
+        return nil, ErrInsufficientDataForOrder
+    }
+    n := len(data)
+    dataMean := mean(data)
+    centered := make(vector, n)
+    for i, value := range data {
+        centered[i] = value - dataMean
+    }
+    negLogLikelihood := func(x []float64) float64 {
+        phi := x[:order]
+        sigma2 := math.Exp(x[order])
+        if !isStationary(phi) {
+            return math.Inf(1)
+        }
+        // Unit innovation variance: sigma2 is applied separately below.
+        gamma, ok := theoreticalAutocovariances(phi, 1, n)
+        if !ok {
+            return math.Inf(1)
+        }
+        toeplitz := newSymmetricSquareMatrix(n)
+        for i := 0; i < n; i++ {
+            for j := i; j < n; j++ {
+                toeplitz.setElement(i, j, gamma[j-i])
+            }
+        }
+        var chol mat.Cholesky
+        if ok := chol.Factorize(toeplitz); !ok {
+            return math.Inf(1)
+        }
+        var solved mat.VecDense
+        if solveErr := chol.SolveVecTo(&solved, centered); solveErr != nil {
+            return math.Inf(1)
+        }
+        quadraticForm := mat.Dot(centered, &solved)
+        return 0.5 * (float64(n) * math.Log(2 * math.Pi * sigma2) + chol.LogDet() + quadraticForm / sigma2)
+    }
+    seed, seedErr := NewModelYuleWalker(data, order)
+    if seedErr != nil {
+        return nil, seedErr
+    }
+    initX := make([]float64, order + 1)
+    copy(initX, seed.coefficients)
+    initX[order] = math.Log(seed.standardError * seed.standardError)
+    problem := optimize.Problem{
+        Func: negLogLikelihood,
+        Grad: func(grad, x []float64) {
+            fd.Gradient(grad, negLogLikelihood, x, &fd.Settings{Formula: fd.Central})
+        },
+    }
+    result, optimizeErr := optimize.Minimize(problem, initX, settings, &optimize.BFGS{})
+    if optimizeErr != nil {
+        return nil, optimizeErr
+    }
+    coefficients := append([]float64{}, result.X[:order]...)
+    if !isStationary(coefficients) {
+        return nil, ErrNonStationary
+    }
+    sigma2 := math.Exp(result.X[order])
+    noise := meanNoise(data, coefficients)
+    return &ARModel{coefficients, noise, math.Sqrt(sigma2), 0, nil, n}, nil
+}
+
+// isStationary reports whether the AR(p) process with coefficients phi is
+// stationary: equivalently, whether every eigenvalue of the companion matrix
+//
+//	[phi_1 phi_2 ... phi_p]
+//	[  1    0   ...   0   ]
+//	[  0    1   ...   0   ]
+//	[ ...            ...  ]
+//	[  0    0   ...1  0   ]
+//
+// has modulus strictly less than one.
+func isStationary(phi []float64) bool {
+    order := len(phi)
+    if order == 0 {
+        return true
+    }
+    companion := mat.NewDense(order, order, nil)
+    for j, coefficient := range phi {
+        companion.Set(0, j, coefficient)
+    }
+    for i := 1; i < order; i++ {
+        companion.Set(i, i - 1, 1)
+    }
+    var eigen mat.Eigen
+    if ok := eigen.Factorize(companion, mat.EigenNone); !ok {
+        return false
+    }
+    for _, value := range eigen.Values(nil) {
+        if cmplx.Abs(value) >= 1 {
+            return false
+        }
+    }
+    return true
+}
+
+// theoreticalAutocovariances solves the Yule-Walker equations
+//
+//	gamma(k) = sum_{j=1}^{order} phi_j * gamma(|k-j|),                k = 1..order
+//	gamma(0) = sum_{j=1}^{order} phi_j * gamma(j) + sigma2,
+//
+// for the theoretical autocovariances gamma(0..order) of a stationary AR(p)
+// process with coefficients phi and innovation variance sigma2, then extends
+// the sequence to length n via the same recursion. It reports false if the
+// system is singular or gamma(0) is non-positive, either of which means phi
+// does not describe a valid stationary process.
+func theoreticalAutocovariances(phi []float64, sigma2 float64, n int) ([]float64, bool) {
+    order := len(phi)
+    size := order + 1
+    a := mat.NewDense(size, size, nil)
+    b := mat.NewVecDense(size, nil)
+    b.SetVec(0, sigma2)
+    for k := 0; k < size; k++ {
+        a.Set(k, k, a.At(k, k) + 1)
+        for j := 1; j <= order; j++ {
+            m := k - j
+            if m < 0 {
+                m = -m
+            }
+            a.Set(k, m, a.At(k, m) - phi[j-1])
+        }
+    }
+    var lu mat.LU
+    lu.Factorize(a)
+    var gammaVec mat.VecDense
+    if solveErr := lu.SolveVecTo(&gammaVec, false, b); solveErr != nil {
+        return nil, false
+    }
+    if gammaVec.AtVec(0) <= 0 {
+        return nil, false
+    }
+    length := n
+    if length < size {
+        length = size
+    }
+    gamma := make([]float64, length)
+    for k := 0; k < size; k++ {
+        gamma[k] = gammaVec.AtVec(k)
+    }
+    for k := size; k < length; k++ {
+        var sum float64 = 0
+        for j := 1; j <= order; j++ {
+            sum += phi[j-1] * gamma[k-j]
+        }
+        gamma[k] = sum
+    }
+    return gamma[:n], true
+}
@@ -0,0 +1,147 @@
+package autoregression
+
+import (
+    "math"
+    "testing"
+    "gonum.org/v1/gonum/mat"
+)
+
+func TestIsStationary(t *testing.T) {
+    if !isStationary([]float64{0.5}) {
+        t.Error("Expected phi=[0.5] to be stationary.")
+    }
+    if isStationary([]float64{1.5}) {
+        t.Error("Expected phi=[1.5] to be non-stationary.")
+    }
+    if !isStationary([]float64{0.3, 0.2}) {
+        t.Error("Expected phi=[0.3, 0.2] to be stationary.")
+    }
+    if isStationary([]float64{0.9, 0.9}) {
+        t.Error("Expected phi=[0.9, 0.9] to be non-stationary.")
+    }
+    if !isStationary(nil) {
+        t.Error("Expected the order-0 process to be stationary.")
+    }
+}
+
+func TestTheoreticalAutocovariances(t *testing.T) {
+    gamma, ok := theoreticalAutocovariances(nil, 2, 3)
+    if !ok {
+        t.Fatal("Expected a valid theoretical autocovariance sequence.")
+    }
+    expected := []float64{2, 0, 0}
+    for lag, value := range expected {
+        if !floatsAreClose(gamma[lag], value, floatTolerance) {
+            t.Errorf("Expected gamma(%d) %.12f but got %.12f.", lag, value, gamma[lag])
+        }
+    }
+    gamma, ok = theoreticalAutocovariances([]float64{0.5}, 1, 3)
+    if !ok {
+        t.Fatal("Expected a valid theoretical autocovariance sequence.")
+    }
+    expected = []float64{float64(4) / 3, float64(2) / 3, float64(1) / 3}
+    for lag, value := range expected {
+        if !floatsAreClose(gamma[lag], value, floatTolerance) {
+            t.Errorf("Expected gamma(%d) %.12f but got %.12f.", lag, value, gamma[lag])
+        }
+    }
+}
+
+func TestTheoreticalAutocovariancesNonStationary(t *testing.T) {
+    _, ok := theoreticalAutocovariances([]float64{1.5}, 1, 3)
+    if ok {
+        t.Error("Expected a non-stationary phi to yield a non-positive-definite theoretical autocovariance sequence.")
+    }
+}
+
+func TestNewModelMLENegativeOrder(t *testing.T) {
+    model, modelErr := NewModelMLE([]float64{2, 7, 1, -3, 2, -2}, -1, nil)
+    if model != nil {
+        t.Error("Expected nil model for negative order, but got non-nil model")
+    }
+    if modelErr != ErrNegativeOrder {
+        t.Errorf("Expected ErrNegativeOrder but got %v", modelErr)
+    }
+}
+
+func TestNewModelMLEInsufficientData(t *testing.T) {
+    model, modelErr := NewModelMLE([]float64{2, 7, 1}, 2, nil)
+    if model != nil {
+        t.Error("Expected nil model for insufficient data, but got non-nil model")
+    }
+    if modelErr != ErrInsufficientDataForOrder {
+        t.Errorf("Expected ErrInsufficientDataForOrder but got %v", modelErr)
+    }
+}
+
+func TestNewModelMLEFit(t *testing.T) {
+    data := []float64{2, 7, 1, -3, 2, -2, 5, -4, 3, 0, 6, -5}
+    order := 1
+    model, modelErr := NewModelMLE(data, order, nil)
+    if modelErr != nil {
+        t.Fatalf("Expected model but got error %v.", modelErr)
+    }
+    if model == nil {
+        t.Fatal("Expected a model but got nil.")
+    }
+    if model.Order() != order {
+        t.Errorf("Expected order %d but got %d", order, model.Order())
+    }
+    if model.StandardError() <= 0 {
+        t.Errorf("Expected a positive standard error but got %.12f", model.StandardError())
+    }
+    if !isStationary(model.coefficients) {
+        t.Errorf("Expected stationary fitted coefficients %v", model.coefficients)
+    }
+}
+
+func TestNewModelMLEImprovesOnYuleWalkerLikelihood(t *testing.T) {
+    data := []float64{2, 7, 1, -3, 2, -2, 5, -4, 3, 0, 6, -5}
+    order := 1
+    seed, seedErr := NewModelYuleWalker(data, order)
+    if seedErr != nil {
+        t.Fatalf("Expected a seed model but got error %v.", seedErr)
+    }
+    model, modelErr := NewModelMLE(data, order, nil)
+    if modelErr != nil {
+        t.Fatalf("Expected model but got error %v.", modelErr)
+    }
+    seedLikelihood := exactGaussianLogLikelihood(data, seed.coefficients, seed.standardError*seed.standardError)
+    fitLikelihood := exactGaussianLogLikelihood(data, model.coefficients, model.standardError*model.standardError)
+    if fitLikelihood < seedLikelihood-floatTolerance {
+        t.Errorf("Expected the MLE fit's log-likelihood %.12f to be at least as good as the Yule-Walker seed's %.12f.", fitLikelihood, seedLikelihood)
+    }
+}
+
+// exactGaussianLogLikelihood independently recomputes the exact Gaussian
+// log-likelihood that NewModelMLE maximizes, reusing its theoretical
+// autocovariance and stationarity building blocks but not its optimization
+// loop, so it can be used to check the quality of a fit from outside it.
+func exactGaussianLogLikelihood(data []float64, phi []float64, sigma2 float64) float64 {
+    n := len(data)
+    dataMean := mean(data)
+    centered := make(vector, n)
+    for i, value := range data {
+        centered[i] = value - dataMean
+    }
+    gamma, ok := theoreticalAutocovariances(phi, 1, n)
+    if !ok {
+        return math.Inf(-1)
+    }
+    toeplitz := newSymmetricSquareMatrix(n)
+    for i := 0; i < n; i++ {
+        for j := i; j < n; j++ {
+            toeplitz.setElement(i, j, gamma[j-i])
+        }
+    }
+    var chol mat.Cholesky
+    if ok := chol.Factorize(toeplitz); !ok {
+        return math.Inf(-1)
+    }
+    var solved mat.VecDense
+    if solveErr := chol.SolveVecTo(&solved, centered); solveErr != nil {
+        return math.Inf(-1)
+    }
+    quadraticForm := mat.Dot(centered, &solved)
+    return -0.5 * (float64(n)*math.Log(2*math.Pi*sigma2) + chol.LogDet() + quadraticForm/sigma2)
+}
@@ -2,15 +2,21 @@ package autoregression
 
 import (
     "errors"
+    "fmt"
     "gonum.org/v1/gonum/mat"
+    "gonum.org/v1/gonum/stat/distuv"
     "math"
+    "strings"
 )
 
 var (
     ErrNegativeOrder = errors.New("autoregression: model order cannot be negative")
     ErrInsufficientDataForOrder = errors.New("autoregression: the number of elements in the data must be greater then one more than twice the order")
     ErrSingularCovariantMatrix = errors.New("autoregression: the covariant matrix generated from the data was singular")
+    ErrNonPositiveDefiniteCovariantMatrix = errors.New("autoregression: the covariant matrix generated from the data was not positive-definite")
     ErrIncorrectDataLength = errors.New("autoregression: the length of the supplied new data was not equal to the order")
+    ErrNegativeHorizon = errors.New("autoregression: forecast horizon cannot be negative")
+    ErrNonStationary = errors.New("autoregression: the fitted AR coefficients describe a non-stationary process")
 )
 
 type (
@@ -18,14 +24,50 @@ type (
         coefficients []float64
         noise float64
         standardError float64
+        logDetCov float64
+        invCov *mat.SymDense
+        sampleSize int
+    }
+
+    // NewModelOLSOptions configures NewModelOLSWithOptions.
+    NewModelOLSOptions struct {
+        // Ridge, when non-zero, adds Ridge*I to the covariant matrix before
+        // factorization, trading a small amount of bias for a better-conditioned
+        // solve on ill-posed or collinear data.
+        Ridge float64
+        // UseLU selects the legacy LU-based solve instead of the Cholesky
+        // factorization. A singular covariant matrix is then reported as
+        // ErrSingularCovariantMatrix rather than ErrNonPositiveDefiniteCovariantMatrix.
+        UseLU bool
     }
     
     symmetricSquareMatrix struct {
        size int
        elements []float64
     }
-    
+
     vector []float64
+
+    // Criterion selects the information criterion NewModelAutoOrder uses to
+    // choose an AR model's order.
+    Criterion int
+
+    // OrderScore records the information criteria for one candidate AR
+    // order, as produced by ModelScores.
+    OrderScore struct {
+        Order int
+        AIC float64
+        BIC float64
+        AICc float64
+        HQIC float64
+    }
+)
+
+const (
+    AIC Criterion = iota
+    BIC
+    AICc
+    HQIC
 )
 
 func newSymmetricSquareMatrix(size int) *symmetricSquareMatrix {
@@ -49,10 +91,20 @@ func (m *symmetricSquareMatrix) T() mat.Matrix {
     return m
 }
 
+func (m *symmetricSquareMatrix) SymmetricDim() int {
+    return m.size
+}
+
 func (m *symmetricSquareMatrix) setElement(r, c int, value float64) {
     m.elements[r * m.size - (r-1) * r / 2 + c - r] = value
 }
 
+func (m *symmetricSquareMatrix) addRidge(lambda float64) {
+    for i := 0; i < m.size; i++ {
+        m.setElement(i, i, m.At(i, i) + lambda)
+    }
+}
+
 func (model *ARModel) Order() int {
     return len(model.coefficients)
 }
@@ -61,6 +113,98 @@ func (model *ARModel) StandardError() float64 {
     return model.standardError
 }
 
+// LogDetCov returns the log-determinant of the covariant matrix used to fit
+// the model, as computed by its Cholesky (or LU, if NewModelOLSOptions.UseLU
+// was set) factorization. Callers can use this to build information criteria
+// without re-factorizing the matrix themselves.
+func (model *ARModel) LogDetCov() float64 {
+    return model.logDetCov
+}
+
+// CoefficientStdErrors returns the standard error of each fitted coefficient,
+// sqrt(sigma^2 * diag((X'X)^-1)), for models that retain the inverse
+// covariant matrix from their fit (currently NewModelOLS with its default
+// Cholesky solver). It returns nil for models that do not retain one.
+func (model *ARModel) CoefficientStdErrors() []float64 {
+    if model.invCov == nil {
+        return nil
+    }
+    variance := model.standardError * model.standardError
+    stdErrors := make([]float64, len(model.coefficients))
+    for i := range stdErrors {
+        stdErrors[i] = math.Sqrt(variance * model.invCov.At(i, i))
+    }
+    return stdErrors
+}
+
+// WaldStatistics returns the Wald t-statistic phi_i / se(phi_i) for each
+// coefficient. It returns nil if CoefficientStdErrors does.
+func (model *ARModel) WaldStatistics() []float64 {
+    stdErrors := model.CoefficientStdErrors()
+    if stdErrors == nil {
+        return nil
+    }
+    statistics := make([]float64, len(model.coefficients))
+    for i, coefficient := range model.coefficients {
+        statistics[i] = coefficient / stdErrors[i]
+    }
+    return statistics
+}
+
+// PValues returns the two-sided p-value of each coefficient's Wald statistic
+// against a Student's t distribution with len(data) - order - 1 degrees of
+// freedom. It returns nil if WaldStatistics does.
+func (model *ARModel) PValues() []float64 {
+    statistics := model.WaldStatistics()
+    if statistics == nil {
+        return nil
+    }
+    degreesOfFreedom := float64(model.sampleSize - len(model.coefficients) - 1)
+    studentsT := distuv.StudentsT{Mu: 0, Sigma: 1, Nu: degreesOfFreedom}
+    pValues := make([]float64, len(statistics))
+    for i, statistic := range statistics {
+        pValues[i] = 2 * (1 - studentsT.CDF(math.Abs(statistic)))
+    }
+    return pValues
+}
+
+// Summary formats a coefficient table (estimate, standard error, t-statistic,
+// p-value) plus the residual variance, degrees of freedom, AIC and BIC.
+// Columns that require the inverse covariant matrix are shown as "-" for
+// models that do not retain one.
+func (model *ARModel) Summary() string {
+    stdErrors := model.CoefficientStdErrors()
+    statistics := model.WaldStatistics()
+    pValues := model.PValues()
+    var builder strings.Builder
+    fmt.Fprintf(&builder, "%-12s%16s%16s%16s%16s\n", "Coefficient", "Estimate", "Std Err", "t", "P>|t|")
+    for i, coefficient := range model.coefficients {
+        name := fmt.Sprintf("phi%d", i+1)
+        if stdErrors == nil {
+            fmt.Fprintf(&builder, "%-12s%16.6f%16s%16s%16s\n", name, coefficient, "-", "-", "-")
+            continue
+        }
+        fmt.Fprintf(&builder, "%-12s%16.6f%16.6f%16.6f%16.6f\n", name, coefficient, stdErrors[i], statistics[i], pValues[i])
+    }
+    order := len(model.coefficients)
+    variance := model.standardError * model.standardError
+    fmt.Fprintf(&builder, "\nResidual variance: %.6f\n", variance)
+    fmt.Fprintf(&builder, "Degrees of freedom: %d\n", model.sampleSize - order - 1)
+    fmt.Fprintf(&builder, "AIC: %.6f\n", akaikeInformationCriterion(variance, order, model.sampleSize))
+    fmt.Fprintf(&builder, "BIC: %.6f\n", bayesianInformationCriterion(variance, order, model.sampleSize))
+    return builder.String()
+}
+
+// akaikeInformationCriterion returns n*ln(sigma2) + 2*(order+1).
+func akaikeInformationCriterion(sigma2 float64, order, n int) float64 {
+    return float64(n) * math.Log(sigma2) + 2 * float64(order + 1)
+}
+
+// bayesianInformationCriterion returns n*ln(sigma2) + (order+1)*ln(n).
+func bayesianInformationCriterion(sigma2 float64, order, n int) float64 {
+    return float64(n) * math.Log(sigma2) + float64(order + 1) * math.Log(float64(n))
+}
+
 func (model *ARModel) Predict(newData []float64) (float64, error) {
     if len(newData) != len(model.coefficients) {
         return 0, ErrIncorrectDataLength
@@ -72,6 +216,75 @@ func (model *ARModel) Predict(newData []float64) (float64, error) {
     return prediction, nil
 }
 
+// PredictN forecasts horizon steps ahead from newData, returning the mean
+// forecast and its propagated standard deviation for h = 1..horizon.
+// Forecasts beyond the first step are rolled forward using the model's own
+// predictions in place of unobserved data; the standard deviations grow with
+// h according to the model's MA(infinity) impulse response.
+func (model *ARModel) PredictN(newData []float64, horizon int) ([]float64, []float64, error) {
+    if len(newData) != len(model.coefficients) {
+        return nil, nil, ErrIncorrectDataLength
+    }
+    if horizon < 0 {
+        return nil, nil, ErrNegativeHorizon
+    }
+    psi := model.impulseResponseWeights(horizon)
+    means := make([]float64, horizon)
+    standardDeviations := make([]float64, horizon)
+    window := append([]float64{}, newData...)
+    var cumulativeSquaredPsi float64 = 0
+    for h := 0; h < horizon; h++ {
+        prediction, predictErr := model.Predict(window)
+        if predictErr != nil {
+            return nil, nil, predictErr
+        }
+        means[h] = prediction
+        cumulativeSquaredPsi += psi[h] * psi[h]
+        standardDeviations[h] = math.Sqrt(cumulativeSquaredPsi) * model.standardError
+        if len(window) > 0 {
+            window = append(window[1:], prediction)
+        }
+    }
+    return means, standardDeviations, nil
+}
+
+// PredictionInterval returns lower, mean and upper forecast bands for
+// h = 1..horizon at confidence level 1-alpha, using the Gaussian quantiles of
+// the forecast standard deviations computed by PredictN.
+func (model *ARModel) PredictionInterval(newData []float64, horizon int, alpha float64) ([]float64, []float64, []float64, error) {
+    means, standardDeviations, predictErr := model.PredictN(newData, horizon)
+    if predictErr != nil {
+        return nil, nil, nil, predictErr
+    }
+    lower := make([]float64, horizon)
+    upper := make([]float64, horizon)
+    for h := 0; h < horizon; h++ {
+        normal := distuv.Normal{Mu: means[h], Sigma: standardDeviations[h]}
+        lower[h] = normal.Quantile(alpha / 2)
+        upper[h] = normal.Quantile(1 - alpha / 2)
+    }
+    return lower, means, upper, nil
+}
+
+// impulseResponseWeights returns the first n MA(infinity) impulse response
+// weights psi_0, psi_1, ..., psi_{n-1} implied by the model's AR
+// coefficients, with psi_0 = 1 and psi_k = sum_{j=1..min(k,order)} phi_j psi_{k-j}.
+func (model *ARModel) impulseResponseWeights(n int) []float64 {
+    psi := make([]float64, n)
+    if n > 0 {
+        psi[0] = 1
+    }
+    order := len(model.coefficients)
+    for k := 1; k < n; k++ {
+        var sum float64 = 0
+        for j := 1; j <= k && j <= order; j++ {
+            sum += model.coefficients[j-1] * psi[k-j]
+        }
+        psi[k] = sum
+    }
+    return psi
+}
+
 func (v vector) Dims() (int, int) {
     return len(v), 1
 }
@@ -94,6 +307,12 @@ func (v vector) Len() int {
 
 // Generate a new AR model using the method of least squares.
 func NewModelOLS(data []float64, order int) (*ARModel, error) {
+    return NewModelOLSWithOptions(data, order, NewModelOLSOptions{})
+}
+
+// NewModelOLSWithOptions is NewModelOLS with control over regularization and
+// the choice of solver; see NewModelOLSOptions.
+func NewModelOLSWithOptions(data []float64, order int, options NewModelOLSOptions) (*ARModel, error) {
     if order < 0 {
         return nil, ErrNegativeOrder
     }
@@ -101,14 +320,35 @@ func NewModelOLS(data []float64, order int) (*ARModel, error) {
         return nil, ErrInsufficientDataForOrder
     }
     cm := makeCovariantMatrix(data, order)
+    if options.Ridge != 0 {
+        cm.addRidge(options.Ridge)
+    }
     cv := makeCovariantVector(data, order)
     var coefficientVector mat.VecDense
+    var logDetCov float64
+    var invCov *mat.SymDense
     if order > 0 {
-        var lu mat.LU
-        lu.Factorize(cm)
-        solveErr := lu.SolveVecTo(&coefficientVector, false, cv)
-        if solveErr != nil {
-            return nil, ErrSingularCovariantMatrix
+        if options.UseLU {
+            var lu mat.LU
+            lu.Factorize(cm)
+            solveErr := lu.SolveVecTo(&coefficientVector, false, cv)
+            if solveErr != nil {
+                return nil, ErrSingularCovariantMatrix
+            }
+            logDetCov, _ = lu.LogDet()
+        } else {
+            var chol mat.Cholesky
+            if ok := chol.Factorize(cm); !ok {
+                return nil, ErrNonPositiveDefiniteCovariantMatrix
+            }
+            if solveErr := chol.SolveVecTo(&coefficientVector, cv); solveErr != nil {
+                return nil, ErrNonPositiveDefiniteCovariantMatrix
+            }
+            logDetCov = chol.LogDet()
+            invCov = mat.NewSymDense(order, nil)
+            if invErr := chol.InverseTo(invCov); invErr != nil {
+                invCov = nil
+            }
         }
     }
     coefficients := make([]float64, order)
@@ -128,10 +368,218 @@ func NewModelOLS(data []float64, order int) (*ARModel, error) {
         noise += data[len(data) - 1 - k] / float64(iterations) - coeffProduct / float64(iterations)
     }
     standardError := math.Sqrt(deviationVariance - noise * noise)
-    return &ARModel{coefficients, noise, standardError}, nil
+    return &ARModel{coefficients, noise, standardError, logDetCov, invCov, len(data)}, nil
+}
+
+// NewModelYuleWalker fits an AR(p) model by solving the Yule-Walker equations
+// for the sample autocovariances of the (mean-centered) data with the
+// Levinson-Durbin recursion. It avoids building the O(n*order) covariant
+// matrix that NewModelOLS does, and tends to be more stable on short series.
+func NewModelYuleWalker(data []float64, order int) (*ARModel, error) {
+    if order < 0 {
+        return nil, ErrNegativeOrder
+    }
+    if len(data) <= 2 * order + 1 {
+        return nil, ErrInsufficientDataForOrder
+    }
+    autocovariances := sampleAutocovariances(data, order)
+    coefficientsByOrder, variances := levinsonDurbin(autocovariances, order)
+    coefficients := coefficientsByOrder[order]
+    noise := meanNoise(data, coefficients)
+    standardError := math.Sqrt(variances[order])
+    return &ARModel{coefficients, noise, standardError, 0, nil, len(data)}, nil
+}
+
+// NewModelBurg fits an AR(p) model with Burg's method, minimizing the
+// forward and backward prediction error directly rather than going through
+// sample autocovariances. It shares the Levinson-Durbin order-recursion used
+// by NewModelYuleWalker and tends to outperform it on short series.
+func NewModelBurg(data []float64, order int) (*ARModel, error) {
+    if order < 0 {
+        return nil, ErrNegativeOrder
+    }
+    if len(data) <= 2 * order + 1 {
+        return nil, ErrInsufficientDataForOrder
+    }
+    n := len(data)
+    dataMean := mean(data)
+    f := make([]float64, n)
+    b := make([]float64, n)
+    var p float64 = 0
+    for index, value := range data {
+        centered := value - dataMean
+        f[index] = centered
+        b[index] = centered
+        p += centered * centered / float64(n)
+    }
+    coefficients := make([]float64, 0)
+    for k := 1; k <= order; k++ {
+        var numerator, denominator float64 = 0, 0
+        for t := k; t < n; t++ {
+            numerator += f[t] * b[t-1]
+            denominator += f[t] * f[t] + b[t-1] * b[t-1]
+        }
+        reflection := 2 * numerator / denominator
+        nextF := make([]float64, n)
+        nextB := make([]float64, n)
+        for t := k; t < n; t++ {
+            nextF[t] = f[t] - reflection * b[t-1]
+            nextB[t] = b[t-1] - reflection * f[t]
+        }
+        coefficients, p = levinsonStep(coefficients, p, reflection, k)
+        f, b = nextF, nextB
+    }
+    noise := meanNoise(data, coefficients)
+    return &ARModel{coefficients, noise, math.Sqrt(p), 0, nil, len(data)}, nil
+}
+
+// NewModelAutoOrder fits AR models of every order 0..maxOrder in a single
+// O(maxOrder^2) Levinson-Durbin pass and returns the model minimizing the
+// chosen information criterion, along with the order it was fitted at.
+func NewModelAutoOrder(data []float64, maxOrder int, criterion Criterion) (*ARModel, int, error) {
+    scores, coefficientsByOrder, variances, scoresErr := orderScores(data, maxOrder)
+    if scoresErr != nil {
+        return nil, 0, scoresErr
+    }
+    bestOrder := 0
+    bestScore := math.Inf(1)
+    for _, score := range scores {
+        if value := score.value(criterion); value < bestScore {
+            bestScore = value
+            bestOrder = score.Order
+        }
+    }
+    coefficients := coefficientsByOrder[bestOrder]
+    noise := meanNoise(data, coefficients)
+    standardError := math.Sqrt(variances[bestOrder])
+    return &ARModel{coefficients, noise, standardError, 0, nil, len(data)}, bestOrder, nil
+}
+
+// ModelScores fits AR models of every order 0..maxOrder and returns their
+// AIC, BIC, AICc and HQIC scores so callers can plot the criterion curve.
+func ModelScores(data []float64, maxOrder int) ([]OrderScore, error) {
+    scores, _, _, scoresErr := orderScores(data, maxOrder)
+    return scores, scoresErr
+}
+
+// value returns the score named by criterion.
+func (score OrderScore) value(criterion Criterion) float64 {
+    switch criterion {
+    case BIC:
+        return score.BIC
+    case AICc:
+        return score.AICc
+    case HQIC:
+        return score.HQIC
+    default:
+        return score.AIC
+    }
+}
+
+// orderScores fits AR models of every order 0..maxOrder in a single
+// Levinson-Durbin pass over the sample autocovariances, returning their
+// information criteria alongside the per-order coefficients and residual
+// variances so NewModelAutoOrder can build its chosen model without
+// re-solving anything.
+func orderScores(data []float64, maxOrder int) ([]OrderScore, [][]float64, []float64, error) {
+    if maxOrder < 0 {
+        return nil, nil, nil, ErrNegativeOrder
+    }
+    if len(data) <= 2 * maxOrder + 1 {
+        return nil, nil, nil, ErrInsufficientDataForOrder
+    }
+    n := len(data)
+    autocovariances := sampleAutocovariances(data, maxOrder)
+    coefficientsByOrder, variances := levinsonDurbin(autocovariances, maxOrder)
+    scores := make([]OrderScore, maxOrder + 1)
+    for p := 0; p <= maxOrder; p++ {
+        aic := akaikeInformationCriterion(variances[p], p, n)
+        aicc := math.Inf(1)
+        if denominator := n - p - 2; denominator > 0 {
+            aicc = aic + 2 * float64((p + 1) * (p + 2)) / float64(denominator)
+        }
+        scores[p] = OrderScore{
+            Order: p,
+            AIC: aic,
+            BIC: bayesianInformationCriterion(variances[p], p, n),
+            AICc: aicc,
+            HQIC: float64(n) * math.Log(variances[p]) + 2 * float64(p + 1) * math.Log(math.Log(float64(n))),
+        }
+    }
+    return scores, coefficientsByOrder, variances, nil
+}
+
+// levinsonStep advances the order-(k-1) AR coefficients phi and prediction
+// error variance p to order k, given the reflection coefficient already
+// computed for this step by whichever recursion is driving it (the
+// Yule-Walker autocovariances in levinsonDurbin, or Burg's forward/backward
+// errors in NewModelBurg).
+func levinsonStep(phi []float64, p, reflection float64, k int) ([]float64, float64) {
+    next := make([]float64, k)
+    for j := 0; j < k - 1; j++ {
+        next[j] = phi[j] - reflection * phi[k - j - 2]
+    }
+    next[k-1] = reflection
+    return next, p * (1 - reflection * reflection)
+}
+
+// levinsonDurbin solves the Yule-Walker equations R*phi = r for every order
+// 0..order via the Levinson-Durbin recursion, returning the coefficients and
+// prediction error variance at each order so that callers needing more than
+// one order (see NewModelAutoOrder) can reuse the O(order^2) work.
+func levinsonDurbin(autocovariances []float64, order int) ([][]float64, []float64) {
+    coefficientsByOrder := make([][]float64, order + 1)
+    variances := make([]float64, order + 1)
+    coefficientsByOrder[0] = make([]float64, 0)
+    variances[0] = autocovariances[0]
+    for k := 1; k <= order; k++ {
+        var numerator float64 = autocovariances[k]
+        for j := 0; j < k - 1; j++ {
+            numerator -= coefficientsByOrder[k-1][j] * autocovariances[k-j-1]
+        }
+        reflection := numerator / variances[k-1]
+        coefficientsByOrder[k], variances[k] = levinsonStep(coefficientsByOrder[k-1], variances[k-1], reflection, k)
+    }
+    return coefficientsByOrder, variances
+}
+
+// sampleAutocovariances returns the mean-centered sample autocovariances
+// r(0), r(1), ..., r(maxLag) of data.
+func sampleAutocovariances(data []float64, maxLag int) []float64 {
+    n := len(data)
+    dataMean := mean(data)
+    autocovariances := make([]float64, maxLag + 1)
+    for lag := 0; lag <= maxLag; lag++ {
+        var sum float64 = 0
+        for t := 0; t < n - lag; t++ {
+            sum += (data[t] - dataMean) * (data[t + lag] - dataMean)
+        }
+        autocovariances[lag] = sum / float64(n)
+    }
+    return autocovariances
+}
+
+// meanNoise returns the intercept term for an AR model fitted on mean-
+// centered data: the constant that, added to the coefficients' contribution,
+// reproduces the series' own mean in steady state.
+func meanNoise(data []float64, coefficients []float64) float64 {
+    var sumCoefficients float64 = 0
+    for _, coefficient := range coefficients {
+        sumCoefficients += coefficient
+    }
+    return mean(data) * (1 - sumCoefficients)
+}
+
+// mean returns the arithmetic mean of data.
+func mean(data []float64) float64 {
+    var sum float64 = 0
+    for _, value := range data {
+        sum += value
+    }
+    return sum / float64(len(data))
 }
 
-func makeCovariantMatrix(data []float64, order int) mat.Matrix {
+func makeCovariantMatrix(data []float64, order int) *symmetricSquareMatrix {
     m := newSymmetricSquareMatrix(order)
     for r := 0; r < order; r++ {
         for c := r; c < order; c++ {
@@ -4,6 +4,7 @@ import (
     "encoding/json"
     "math"
     "reflect"
+    "strings"
     "testing"
 )
 
@@ -122,6 +123,378 @@ func TestModelPredictionWithZeroOrder(t *testing.T) {
     }
 }
 
+func TestModelScores(t *testing.T) {
+    data := []float64{2, 7, 1, -3, 2, -2, 5, -4, 3, 0, -1, 6}
+    maxOrder := 3
+    scores, scoresErr := ModelScores(data, maxOrder)
+    if scoresErr != nil {
+        t.Fatalf("Expected scores but got error %v.", scoresErr)
+    }
+    if len(scores) != maxOrder + 1 {
+        t.Fatalf("Expected %d scores but got %d", maxOrder + 1, len(scores))
+    }
+    for order, score := range scores {
+        if score.Order != order {
+            t.Errorf("Expected score %d to have Order %d but got %d", order, order, score.Order)
+        }
+        model, modelErr := NewModelYuleWalker(data, order)
+        if modelErr != nil {
+            t.Fatalf("Expected model but got error %v.", modelErr)
+        }
+        variance := model.StandardError() * model.StandardError()
+        expectedAIC := float64(len(data)) * math.Log(variance) + 2 * float64(order + 1)
+        if !floatsAreClose(score.AIC, expectedAIC, floatTolerance) {
+            t.Errorf("Order %d: expected AIC %.12f but got %.12f.", order, expectedAIC, score.AIC)
+        }
+        expectedBIC := float64(len(data)) * math.Log(variance) + float64(order + 1) * math.Log(float64(len(data)))
+        if !floatsAreClose(score.BIC, expectedBIC, floatTolerance) {
+            t.Errorf("Order %d: expected BIC %.12f but got %.12f.", order, expectedBIC, score.BIC)
+        }
+    }
+}
+
+func TestModelScoresNegativeOrder(t *testing.T) {
+    data := []float64{2, 7, 1, -3, 2, -2}
+    _, scoresErr := ModelScores(data, -1)
+    if scoresErr != ErrNegativeOrder {
+        t.Errorf("Expected ErrNegativeOrder but got %v", scoresErr)
+    }
+}
+
+func TestModelScoresInsufficientData(t *testing.T) {
+    data := []float64{2, 7, 1, -3, 2}
+    _, scoresErr := ModelScores(data, 2)
+    if scoresErr != ErrInsufficientDataForOrder {
+        t.Errorf("Expected ErrInsufficientDataForOrder but got %v", scoresErr)
+    }
+}
+
+func TestNewModelAutoOrder(t *testing.T) {
+    data := []float64{2, 7, 1, -3, 2, -2, 5, -4, 3, 0, -1, 6}
+    maxOrder := 3
+    model, order, modelErr := NewModelAutoOrder(data, maxOrder, BIC)
+    if modelErr != nil {
+        t.Fatalf("Expected model but got error %v.", modelErr)
+    }
+    if model == nil {
+        t.Fatal("Expected a model but got nil.")
+    }
+    scores, scoresErr := ModelScores(data, maxOrder)
+    if scoresErr != nil {
+        t.Fatalf("Expected scores but got error %v.", scoresErr)
+    }
+    bestOrder := 0
+    bestScore := scores[0].BIC
+    for _, score := range scores {
+        if score.BIC < bestScore {
+            bestScore = score.BIC
+            bestOrder = score.Order
+        }
+    }
+    if order != bestOrder {
+        t.Errorf("Expected the minimizing order %d but got %d", bestOrder, order)
+    }
+    if model.Order() != order {
+        t.Errorf("Expected model order %d but got %d", order, model.Order())
+    }
+}
+
+func TestModelCoefficientInference(t *testing.T) {
+    data := []float64{2, 7, 1, -3, 2, -2, 5, -4, 3, 0, -1, 6}
+    order := 2
+    model, modelErr := NewModelOLS(data, order)
+    if modelErr != nil {
+        t.Fatalf("Expected model but got error %v.", modelErr)
+    }
+    stdErrors := model.CoefficientStdErrors()
+    if stdErrors == nil {
+        t.Fatal("Expected coefficient standard errors but got nil.")
+    }
+    if len(stdErrors) != order {
+        t.Fatalf("Expected %d standard errors but got %d", order, len(stdErrors))
+    }
+    statistics := model.WaldStatistics()
+    if len(statistics) != order {
+        t.Fatalf("Expected %d Wald statistics but got %d", order, len(statistics))
+    }
+    for i, coefficient := range model.coefficients {
+        expectedStatistic := coefficient / stdErrors[i]
+        if !floatsAreClose(statistics[i], expectedStatistic, floatTolerance) {
+            t.Errorf("Expected Wald statistic %.12f but got %.12f.", expectedStatistic, statistics[i])
+        }
+    }
+    pValues := model.PValues()
+    if len(pValues) != order {
+        t.Fatalf("Expected %d p-values but got %d", order, len(pValues))
+    }
+    for _, pValue := range pValues {
+        if pValue < 0 || pValue > 1 {
+            t.Errorf("Expected p-value in [0, 1] but got %.12f", pValue)
+        }
+    }
+    summary := model.Summary()
+    if !strings.Contains(summary, "phi1") || !strings.Contains(summary, "AIC") || !strings.Contains(summary, "BIC") {
+        t.Errorf("Expected summary to contain coefficient rows and information criteria, got:\n%s", summary)
+    }
+}
+
+func TestModelCoefficientInferenceUnavailable(t *testing.T) {
+    data := []float64{2, 7, 1, -3, 2, -2}
+    order := 2
+    model, modelErr := NewModelYuleWalker(data, order)
+    if modelErr != nil {
+        t.Fatalf("Expected model but got error %v.", modelErr)
+    }
+    if model.CoefficientStdErrors() != nil {
+        t.Error("Expected nil coefficient standard errors for a model with no inverse covariant matrix.")
+    }
+    if model.WaldStatistics() != nil {
+        t.Error("Expected nil Wald statistics for a model with no inverse covariant matrix.")
+    }
+    if model.PValues() != nil {
+        t.Error("Expected nil p-values for a model with no inverse covariant matrix.")
+    }
+}
+
+func TestModelPredictN(t *testing.T) {
+    data := []float64{2, 7, 1, -3, 2, -2}
+    order := 2
+    model, modelErr := NewModelOLS(data, order)
+    if modelErr != nil {
+        t.Fatalf("Expected model but got error %v.", modelErr)
+    }
+    newData := []float64{66, 88}
+    horizon := 3
+    means, standardDeviations, predictErr := model.PredictN(newData, horizon)
+    if predictErr != nil {
+        t.Fatalf("Expected forecast but got error %v.", predictErr)
+    }
+    if len(means) != horizon || len(standardDeviations) != horizon {
+        t.Fatalf("Expected %d forecasts but got %d means and %d standard deviations", horizon, len(means), len(standardDeviations))
+    }
+    expectedFirstMean, predictErr := model.Predict(newData)
+    if predictErr != nil {
+        t.Fatalf("Expected prediction but got error %v.", predictErr)
+    }
+    if !floatsAreClose(means[0], expectedFirstMean, floatTolerance) {
+        t.Errorf("Expected first forecast %.12f but got %.12f.", expectedFirstMean, means[0])
+    }
+    if !floatsAreClose(standardDeviations[0], model.StandardError(), floatTolerance) {
+        t.Errorf("Expected first forecast standard deviation %.12f but got %.12f.", model.StandardError(), standardDeviations[0])
+    }
+    for h := 1; h < horizon; h++ {
+        if standardDeviations[h] <= standardDeviations[h-1] {
+            t.Errorf("Expected forecast standard deviation to grow with horizon, but sd[%d]=%.12f <= sd[%d]=%.12f", h, standardDeviations[h], h-1, standardDeviations[h-1])
+        }
+    }
+}
+
+func TestModelPredictNNegativeHorizon(t *testing.T) {
+    data := []float64{2, 7, 1, -3, 2, -2}
+    model, modelErr := NewModelOLS(data, 2)
+    if modelErr != nil {
+        t.Fatalf("Expected model but got error %v.", modelErr)
+    }
+    _, _, predictErr := model.PredictN([]float64{66, 88}, -1)
+    if predictErr != ErrNegativeHorizon {
+        t.Errorf("Expected ErrNegativeHorizon but got %v", predictErr)
+    }
+}
+
+func TestModelPredictionInterval(t *testing.T) {
+    data := []float64{2, 7, 1, -3, 2, -2}
+    model, modelErr := NewModelOLS(data, 2)
+    if modelErr != nil {
+        t.Fatalf("Expected model but got error %v.", modelErr)
+    }
+    newData := []float64{66, 88}
+    horizon := 2
+    lower, means, upper, intervalErr := model.PredictionInterval(newData, horizon, 0.05)
+    if intervalErr != nil {
+        t.Fatalf("Expected prediction interval but got error %v.", intervalErr)
+    }
+    for h := 0; h < horizon; h++ {
+        if !(lower[h] < means[h] && means[h] < upper[h]) {
+            t.Errorf("Expected lower[%d]=%.6f < mean[%d]=%.6f < upper[%d]=%.6f", h, lower[h], h, means[h], h, upper[h])
+        }
+    }
+}
+
+func TestNewModelYuleWalker(t *testing.T) {
+    data := []float64{2, 7, 1, -3, 2, -2}
+    order := 1
+    model, modelErr := NewModelYuleWalker(data, order)
+    if modelErr != nil {
+        t.Fatalf("Expected model but got error %v.", modelErr)
+    }
+    if model == nil {
+        t.Fatal("Expected a model but got nil.")
+    }
+    var dataSum float64 = 0
+    for _, value := range data {
+        dataSum += value
+    }
+    dataMean := dataSum / float64(len(data))
+    var r0, r1 float64 = 0, 0
+    for _, value := range data {
+        r0 += (value - dataMean) * (value - dataMean)
+    }
+    r0 /= float64(len(data))
+    for index := 0; index < len(data) - 1; index++ {
+        r1 += (data[index] - dataMean) * (data[index+1] - dataMean)
+    }
+    r1 /= float64(len(data))
+    expectedCoefficient := r1 / r0
+    expectedNoise := dataMean * (1 - expectedCoefficient)
+    expectedStandardError := math.Sqrt(r0 * (1 - expectedCoefficient * expectedCoefficient))
+    if len(model.coefficients) != 1 {
+        t.Fatalf("Expected 1 coefficient but got %d", len(model.coefficients))
+    }
+    if !floatsAreClose(model.coefficients[0], expectedCoefficient, floatTolerance) {
+        t.Errorf("Expected coefficient %.12f but got %.12f.", expectedCoefficient, model.coefficients[0])
+    }
+    if !floatsAreClose(model.noise, expectedNoise, floatTolerance) {
+        t.Errorf("Expected noise %.12f but got %.12f.", expectedNoise, model.noise)
+    }
+    if !floatsAreClose(model.StandardError(), expectedStandardError, floatTolerance) {
+        t.Errorf("Expected standard error %.12f but got %.12f.", expectedStandardError, model.StandardError())
+    }
+}
+
+func TestNewModelYuleWalkerNegativeOrder(t *testing.T) {
+    data := []float64{2, 7, 1, -3, 2, -2}
+    model, modelErr := NewModelYuleWalker(data, -1)
+    if model != nil {
+        t.Error("Expected nil model for negative order, but got non-nil model")
+    }
+    if modelErr != ErrNegativeOrder {
+        t.Errorf("Expected ErrNegativeOrder error for negative order but got %v", modelErr)
+    }
+}
+
+func TestNewModelYuleWalkerInsufficientData(t *testing.T) {
+    data := []float64{2, 7, 1, -3, 2}
+    model, modelErr := NewModelYuleWalker(data, 2)
+    if model != nil {
+        t.Error("Expected nil model for insufficient data, but got non-nil model")
+    }
+    if modelErr != ErrInsufficientDataForOrder {
+        t.Errorf("Expected ErrInsufficientDataForOrder error for insufficient data but got %v", modelErr)
+    }
+}
+
+func TestNewModelBurg(t *testing.T) {
+    data := []float64{2, 7, 1, -3, 2, -2}
+    order := 1
+    model, modelErr := NewModelBurg(data, order)
+    if modelErr != nil {
+        t.Fatalf("Expected model but got error %v.", modelErr)
+    }
+    if model == nil {
+        t.Fatal("Expected a model but got nil.")
+    }
+    var dataSum float64 = 0
+    for _, value := range data {
+        dataSum += value
+    }
+    dataMean := dataSum / float64(len(data))
+    centered := make([]float64, len(data))
+    for index, value := range data {
+        centered[index] = value - dataMean
+    }
+    var numerator, denominator float64 = 0, 0
+    for t := 1; t < len(centered); t++ {
+        numerator += centered[t] * centered[t-1]
+        denominator += centered[t] * centered[t] + centered[t-1] * centered[t-1]
+    }
+    expectedCoefficient := 2 * numerator / denominator
+    var p float64 = 0
+    for _, value := range centered {
+        p += value * value / float64(len(centered))
+    }
+    expectedStandardError := math.Sqrt(p * (1 - expectedCoefficient * expectedCoefficient))
+    expectedNoise := dataMean * (1 - expectedCoefficient)
+    if len(model.coefficients) != 1 {
+        t.Fatalf("Expected 1 coefficient but got %d", len(model.coefficients))
+    }
+    if !floatsAreClose(model.coefficients[0], expectedCoefficient, floatTolerance) {
+        t.Errorf("Expected coefficient %.12f but got %.12f.", expectedCoefficient, model.coefficients[0])
+    }
+    if !floatsAreClose(model.noise, expectedNoise, floatTolerance) {
+        t.Errorf("Expected noise %.12f but got %.12f.", expectedNoise, model.noise)
+    }
+    if !floatsAreClose(model.StandardError(), expectedStandardError, floatTolerance) {
+        t.Errorf("Expected standard error %.12f but got %.12f.", expectedStandardError, model.StandardError())
+    }
+}
+
+func TestNewModelBurgNegativeOrder(t *testing.T) {
+    data := []float64{2, 7, 1, -3, 2, -2}
+    model, modelErr := NewModelBurg(data, -1)
+    if model != nil {
+        t.Error("Expected nil model for negative order, but got non-nil model")
+    }
+    if modelErr != ErrNegativeOrder {
+        t.Errorf("Expected ErrNegativeOrder error for negative order but got %v", modelErr)
+    }
+}
+
+func TestNewModelBurgInsufficientData(t *testing.T) {
+    data := []float64{2, 7, 1, -3, 2}
+    model, modelErr := NewModelBurg(data, 2)
+    if model != nil {
+        t.Error("Expected nil model for insufficient data, but got non-nil model")
+    }
+    if modelErr != ErrInsufficientDataForOrder {
+        t.Errorf("Expected ErrInsufficientDataForOrder error for insufficient data but got %v", modelErr)
+    }
+}
+
+func TestNewModelOLSWithOptionsMatchesLUDeterminant(t *testing.T) {
+    data := []float64{2, 7, 1, -3, 2, -2}
+    order := 2
+    cholModel, cholErr := NewModelOLSWithOptions(data, order, NewModelOLSOptions{})
+    if cholErr != nil {
+        t.Fatalf("Expected model but got error %v.", cholErr)
+    }
+    luModel, luErr := NewModelOLSWithOptions(data, order, NewModelOLSOptions{UseLU: true})
+    if luErr != nil {
+        t.Fatalf("Expected model but got error %v.", luErr)
+    }
+    for index, value := range cholModel.coefficients {
+        if !floatsAreClose(value, luModel.coefficients[index], floatTolerance) {
+            t.Errorf("Coefficient %d should be %.12f but is %.12f.", index, value, luModel.coefficients[index])
+        }
+    }
+    if !floatsAreClose(cholModel.LogDetCov(), luModel.LogDetCov(), floatTolerance) {
+        t.Errorf("Expected LogDetCov of %.12f but got %.12f.", luModel.LogDetCov(), cholModel.LogDetCov())
+    }
+}
+
+func TestNewModelOLSWithOptionsNonPositiveDefinite(t *testing.T) {
+    data := []float64{5, 5, 5, 5, 5, 5, 5}
+    order := 2
+    model, modelErr := NewModelOLSWithOptions(data, order, NewModelOLSOptions{})
+    if model != nil {
+        t.Error("Expected nil model for a non-positive-definite covariant matrix, but got non-nil model")
+    }
+    if modelErr != ErrNonPositiveDefiniteCovariantMatrix {
+        t.Errorf("Expected ErrNonPositiveDefiniteCovariantMatrix but got %v", modelErr)
+    }
+}
+
+func TestNewModelOLSWithOptionsRidge(t *testing.T) {
+    data := []float64{5, 5, 5, 5, 5, 5, 5}
+    order := 2
+    model, modelErr := NewModelOLSWithOptions(data, order, NewModelOLSOptions{Ridge: 1})
+    if modelErr != nil {
+        t.Fatalf("Expected model but got error %v.", modelErr)
+    }
+    if model == nil {
+        t.Fatal("Expected a model but got nil.")
+    }
+}
+
 func TestJsonEncoding(t *testing.T) {
     firstModel := ARModel{coefficients: []float64{-0.5916191048362872,0.49113848002403127},
                           noise: -4.069465304896365,
@@ -0,0 +1,274 @@
+package autoregression
+
+import (
+    "errors"
+    "gonum.org/v1/gonum/mat"
+)
+
+var (
+    ErrNoVariables = errors.New("autoregression: a VAR model requires at least one variable")
+    ErrMismatchedVariableLengths = errors.New("autoregression: all variables in a VAR model must have the same length")
+    ErrIncorrectVariableCount = errors.New("autoregression: the number of variables in the supplied new data did not match the model")
+)
+
+// VARModel is the multivariate counterpart to ARModel: a vector
+// autoregression fitted on numVariables equal-length time series.
+type VARModel struct {
+    numVariables int
+    order int
+    coefficients *mat.Dense // numVariables x (numVariables * order); row j holds output j's coefficients, ordered lag 1..order, each lag holding all numVariables inputs.
+    noise []float64 // per-output intercept; see meanNoise for the univariate analogue.
+    residualCov *mat.SymDense // numVariables x numVariables
+}
+
+// NewVARModelOLS fits a VAR(p) model by least squares: for each of the
+// numVariables output series, the normal equations X'X beta = X'y are solved
+// against the shared lagged-regressor matrix X, reusing the same
+// Cholesky-based solve as NewModelOLS. As with NewModelOLS, the regressors and
+// targets are mean-centered before the normal equations are formed, and each
+// output's intercept is recovered afterwards so that Predict/Forecast are
+// unbiased on data that isn't already zero-mean.
+func NewVARModelOLS(data [][]float64, order int) (*VARModel, error) {
+    if order < 0 {
+        return nil, ErrNegativeOrder
+    }
+    numVariables := len(data)
+    if numVariables == 0 {
+        return nil, ErrNoVariables
+    }
+    n := len(data[0])
+    for _, series := range data {
+        if len(series) != n {
+            return nil, ErrMismatchedVariableLengths
+        }
+    }
+    iterations := n - order
+    numRegressors := numVariables * order
+    if iterations <= numRegressors {
+        return nil, ErrInsufficientDataForOrder
+    }
+    designValue := func(t, lag, variable int) float64 {
+        return data[variable][order+t-lag]
+    }
+    targetValue := func(t, variable int) float64 {
+        return data[variable][order+t]
+    }
+    var coefficients *mat.Dense
+    noise := make([]float64, numVariables)
+    if numRegressors > 0 {
+        regressorSums := make([]float64, numRegressors)
+        for c := 0; c < numRegressors; c++ {
+            lag, variable := c / numVariables + 1, c % numVariables
+            var sum float64 = 0
+            for t := 0; t < iterations; t++ {
+                sum += designValue(t, lag, variable)
+            }
+            regressorSums[c] = sum
+        }
+        targetSums := make([]float64, numVariables)
+        for j := 0; j < numVariables; j++ {
+            var sum float64 = 0
+            for t := 0; t < iterations; t++ {
+                sum += targetValue(t, j)
+            }
+            targetSums[j] = sum
+        }
+        coefficients = mat.NewDense(numVariables, numRegressors, nil)
+        xtx := newSymmetricSquareMatrix(numRegressors)
+        for c1 := 0; c1 < numRegressors; c1++ {
+            lag1, variable1 := c1 / numVariables + 1, c1 % numVariables
+            for c2 := c1; c2 < numRegressors; c2++ {
+                lag2, variable2 := c2 / numVariables + 1, c2 % numVariables
+                var sum float64 = 0
+                for t := 0; t < iterations; t++ {
+                    sum += designValue(t, lag1, variable1) * designValue(t, lag2, variable2)
+                }
+                xtx.setElement(c1, c2, sum-regressorSums[c1]*regressorSums[c2]/float64(iterations))
+            }
+        }
+        xty := mat.NewDense(numRegressors, numVariables, nil)
+        for c := 0; c < numRegressors; c++ {
+            lag, variable := c / numVariables + 1, c % numVariables
+            for j := 0; j < numVariables; j++ {
+                var sum float64 = 0
+                for t := 0; t < iterations; t++ {
+                    sum += designValue(t, lag, variable) * targetValue(t, j)
+                }
+                xty.Set(c, j, sum-regressorSums[c]*targetSums[j]/float64(iterations))
+            }
+        }
+        var chol mat.Cholesky
+        if ok := chol.Factorize(xtx); !ok {
+            return nil, ErrNonPositiveDefiniteCovariantMatrix
+        }
+        var beta mat.Dense
+        if solveErr := chol.SolveTo(&beta, xty); solveErr != nil {
+            return nil, ErrNonPositiveDefiniteCovariantMatrix
+        }
+        for j := 0; j < numVariables; j++ {
+            for c := 0; c < numRegressors; c++ {
+                coefficients.Set(j, c, beta.At(c, j))
+            }
+        }
+        for j := 0; j < numVariables; j++ {
+            contribution := targetSums[j] / float64(iterations)
+            for c := 0; c < numRegressors; c++ {
+                contribution -= coefficients.At(j, c) * regressorSums[c] / float64(iterations)
+            }
+            noise[j] = contribution
+        }
+    } else {
+        for j := 0; j < numVariables; j++ {
+            var sum float64 = 0
+            for t := 0; t < iterations; t++ {
+                sum += targetValue(t, j)
+            }
+            noise[j] = sum / float64(iterations)
+        }
+    }
+    model := &VARModel{numVariables, order, coefficients, noise, nil}
+    residuals := make([][]float64, numVariables)
+    for j := range residuals {
+        residuals[j] = make([]float64, iterations)
+    }
+    for t := 0; t < iterations; t++ {
+        window := make([][]float64, numVariables)
+        for i := 0; i < numVariables; i++ {
+            window[i] = data[i][t : t+order]
+        }
+        prediction, predictErr := model.Predict(window)
+        if predictErr != nil {
+            return nil, predictErr
+        }
+        for j := 0; j < numVariables; j++ {
+            residuals[j][t] = data[j][order+t] - prediction[j]
+        }
+    }
+    residualCov := mat.NewSymDense(numVariables, nil)
+    for r := 0; r < numVariables; r++ {
+        for c := r; c < numVariables; c++ {
+            var sum float64 = 0
+            for t := 0; t < iterations; t++ {
+                sum += residuals[r][t] * residuals[c][t]
+            }
+            residualCov.SetSym(r, c, sum / float64(iterations))
+        }
+    }
+    model.residualCov = residualCov
+    return model, nil
+}
+
+// Order returns the model's lag order.
+func (model *VARModel) Order() int {
+    return model.order
+}
+
+// NumVariables returns the number of variables the model was fitted on.
+func (model *VARModel) NumVariables() int {
+    return model.numVariables
+}
+
+// Predict returns the one-step-ahead forecast for every variable given the
+// last order observations of each of the numVariables series, oldest first.
+func (model *VARModel) Predict(newData [][]float64) ([]float64, error) {
+    if len(newData) != model.numVariables {
+        return nil, ErrIncorrectVariableCount
+    }
+    for _, series := range newData {
+        if len(series) != model.order {
+            return nil, ErrIncorrectDataLength
+        }
+    }
+    predictions := make([]float64, model.numVariables)
+    for j := 0; j < model.numVariables; j++ {
+        prediction := model.noise[j]
+        for lag := 1; lag <= model.order; lag++ {
+            for i := 0; i < model.numVariables; i++ {
+                coefficient := model.coefficients.At(j, (lag-1)*model.numVariables+i)
+                prediction += coefficient * newData[i][model.order-lag]
+            }
+        }
+        predictions[j] = prediction
+    }
+    return predictions, nil
+}
+
+// Forecast rolls Predict forward horizon steps, returning the mean path for
+// every variable and the propagated forecast error covariance at the final
+// horizon, computed from the multivariate impulse responses
+// Psi_0 = I, Psi_k = sum_{j=1..min(k,order)} A_j Psi_{k-j}, via
+// Sigma_h = sum_{k=0..h-1} Psi_k Sigma Psi_k'.
+func (model *VARModel) Forecast(newData [][]float64, horizon int) ([][]float64, *mat.SymDense, error) {
+    if horizon < 0 {
+        return nil, nil, ErrNegativeHorizon
+    }
+    window := make([][]float64, model.numVariables)
+    for i, series := range newData {
+        window[i] = append([]float64{}, series...)
+    }
+    means := make([][]float64, horizon)
+    for h := 0; h < horizon; h++ {
+        prediction, predictErr := model.Predict(window)
+        if predictErr != nil {
+            return nil, nil, predictErr
+        }
+        means[h] = prediction
+        for i := 0; i < model.numVariables; i++ {
+            if len(window[i]) > 0 {
+                window[i] = append(window[i][1:], prediction[i])
+            }
+        }
+    }
+    impulseResponses := model.impulseResponseMatrices(horizon)
+    accumulator := mat.NewDense(model.numVariables, model.numVariables, nil)
+    for h := 0; h < horizon; h++ {
+        var scaled mat.Dense
+        scaled.Mul(impulseResponses[h], model.residualCov)
+        var term mat.Dense
+        term.Mul(&scaled, impulseResponses[h].T())
+        accumulator.Add(accumulator, &term)
+    }
+    covariance := mat.NewSymDense(model.numVariables, nil)
+    for r := 0; r < model.numVariables; r++ {
+        for c := r; c < model.numVariables; c++ {
+            covariance.SetSym(r, c, accumulator.At(r, c))
+        }
+    }
+    return means, covariance, nil
+}
+
+// impulseResponseMatrices returns the first n multivariate impulse response
+// matrices Psi_0, ..., Psi_{n-1} implied by the model's lag coefficient
+// matrices A_1..A_order.
+func (model *VARModel) impulseResponseMatrices(n int) []*mat.Dense {
+    psi := make([]*mat.Dense, n)
+    if n > 0 {
+        identity := mat.NewDense(model.numVariables, model.numVariables, nil)
+        for i := 0; i < model.numVariables; i++ {
+            identity.Set(i, i, 1)
+        }
+        psi[0] = identity
+    }
+    for k := 1; k < n; k++ {
+        sum := mat.NewDense(model.numVariables, model.numVariables, nil)
+        for j := 1; j <= k && j <= model.order; j++ {
+            var product mat.Dense
+            product.Mul(model.lagMatrix(j), psi[k-j])
+            sum.Add(sum, &product)
+        }
+        psi[k] = sum
+    }
+    return psi
+}
+
+// lagMatrix returns the numVariables x numVariables coefficient matrix A_lag
+// relating the lag-th lagged observation of every variable to every output.
+func (model *VARModel) lagMatrix(lag int) *mat.Dense {
+    a := mat.NewDense(model.numVariables, model.numVariables, nil)
+    for row := 0; row < model.numVariables; row++ {
+        for col := 0; col < model.numVariables; col++ {
+            a.Set(row, col, model.coefficients.At(row, (lag-1)*model.numVariables+col))
+        }
+    }
+    return a
+}
@@ -0,0 +1,185 @@
+package autoregression
+
+import (
+    "testing"
+)
+
+func TestNewVARModelOLS(t *testing.T) {
+    data := [][]float64{
+        {2, 7, 1, -3, 2, -2, 5, -4, 3, 0},
+        {1, -1, 3, 2, -2, 4, -3, 1, 0, 2},
+    }
+    order := 1
+    model, modelErr := NewVARModelOLS(data, order)
+    if modelErr != nil {
+        t.Fatalf("Expected model but got error %v.", modelErr)
+    }
+    if model == nil {
+        t.Fatal("Expected a model but got nil.")
+    }
+    if model.Order() != order {
+        t.Errorf("Expected order %d but got %d", order, model.Order())
+    }
+    if model.NumVariables() != len(data) {
+        t.Errorf("Expected %d variables but got %d", len(data), model.NumVariables())
+    }
+}
+
+func TestNewVARModelOLSMismatchedLengths(t *testing.T) {
+    data := [][]float64{
+        {2, 7, 1, -3},
+        {1, -1, 3},
+    }
+    model, modelErr := NewVARModelOLS(data, 1)
+    if model != nil {
+        t.Error("Expected nil model for mismatched variable lengths, but got non-nil model")
+    }
+    if modelErr != ErrMismatchedVariableLengths {
+        t.Errorf("Expected ErrMismatchedVariableLengths but got %v", modelErr)
+    }
+}
+
+func TestNewVARModelOLSNegativeOrder(t *testing.T) {
+    data := [][]float64{{2, 7, 1, -3}, {1, -1, 3, 2}}
+    model, modelErr := NewVARModelOLS(data, -1)
+    if model != nil {
+        t.Error("Expected nil model for negative order, but got non-nil model")
+    }
+    if modelErr != ErrNegativeOrder {
+        t.Errorf("Expected ErrNegativeOrder but got %v", modelErr)
+    }
+}
+
+func TestNewVARModelOLSInsufficientData(t *testing.T) {
+    data := [][]float64{{2, 7, 1}, {1, -1, 3}}
+    model, modelErr := NewVARModelOLS(data, 2)
+    if model != nil {
+        t.Error("Expected nil model for insufficient data, but got non-nil model")
+    }
+    if modelErr != ErrInsufficientDataForOrder {
+        t.Errorf("Expected ErrInsufficientDataForOrder but got %v", modelErr)
+    }
+}
+
+func TestVARModelPredict(t *testing.T) {
+    data := [][]float64{
+        {2, 7, 1, -3, 2, -2, 5, -4, 3, 0},
+        {1, -1, 3, 2, -2, 4, -3, 1, 0, 2},
+    }
+    order := 1
+    model, modelErr := NewVARModelOLS(data, order)
+    if modelErr != nil {
+        t.Fatalf("Expected model but got error %v.", modelErr)
+    }
+    newData := [][]float64{{5}, {-1}}
+    prediction, predictErr := model.Predict(newData)
+    if predictErr != nil {
+        t.Fatalf("Expected prediction but got error %v.", predictErr)
+    }
+    if len(prediction) != len(data) {
+        t.Fatalf("Expected %d predictions but got %d", len(data), len(prediction))
+    }
+    expected0, expected1 := model.noise[0], model.noise[1]
+    for i := range data {
+        expected0 += model.coefficients.At(0, i) * newData[i][0]
+        expected1 += model.coefficients.At(1, i) * newData[i][0]
+    }
+    if !floatsAreClose(prediction[0], expected0, floatTolerance) {
+        t.Errorf("Expected prediction[0] %.12f but got %.12f.", expected0, prediction[0])
+    }
+    if !floatsAreClose(prediction[1], expected1, floatTolerance) {
+        t.Errorf("Expected prediction[1] %.12f but got %.12f.", expected1, prediction[1])
+    }
+}
+
+func TestNewVARModelOLSCentersNonZeroMeanData(t *testing.T) {
+    data := [][]float64{
+        {102, 107, 101, 97, 102, 98, 105, 96, 103, 100},
+        {51, 49, 53, 52, 48, 54, 47, 51, 50, 52},
+    }
+    order := 1
+    model, modelErr := NewVARModelOLS(data, order)
+    if modelErr != nil {
+        t.Fatalf("Expected model but got error %v.", modelErr)
+    }
+    iterations := len(data[0]) - order
+    var meanResidual0, meanResidual1 float64 = 0, 0
+    for step := 0; step < iterations; step++ {
+        window := [][]float64{{data[0][step]}, {data[1][step]}}
+        prediction, predictErr := model.Predict(window)
+        if predictErr != nil {
+            t.Fatalf("Expected prediction but got error %v.", predictErr)
+        }
+        meanResidual0 += (data[0][order+step] - prediction[0]) / float64(iterations)
+        meanResidual1 += (data[1][order+step] - prediction[1]) / float64(iterations)
+    }
+    if !floatsAreClose(meanResidual0, 0, floatTolerance) {
+        t.Errorf("Expected mean residual[0] near 0 but got %.12f", meanResidual0)
+    }
+    if !floatsAreClose(meanResidual1, 0, floatTolerance) {
+        t.Errorf("Expected mean residual[1] near 0 but got %.12f", meanResidual1)
+    }
+}
+
+func TestVARModelPredictIncorrectVariableCount(t *testing.T) {
+    data := [][]float64{{2, 7, 1, -3}, {1, -1, 3, 2}}
+    model, modelErr := NewVARModelOLS(data, 1)
+    if modelErr != nil {
+        t.Fatalf("Expected model but got error %v.", modelErr)
+    }
+    _, predictErr := model.Predict([][]float64{{5}})
+    if predictErr != ErrIncorrectVariableCount {
+        t.Errorf("Expected ErrIncorrectVariableCount but got %v", predictErr)
+    }
+}
+
+func TestVARModelForecast(t *testing.T) {
+    data := [][]float64{
+        {2, 7, 1, -3, 2, -2, 5, -4, 3, 0},
+        {1, -1, 3, 2, -2, 4, -3, 1, 0, 2},
+    }
+    order := 1
+    model, modelErr := NewVARModelOLS(data, order)
+    if modelErr != nil {
+        t.Fatalf("Expected model but got error %v.", modelErr)
+    }
+    newData := [][]float64{{5}, {-1}}
+    horizon := 3
+    means, covariance, forecastErr := model.Forecast(newData, horizon)
+    if forecastErr != nil {
+        t.Fatalf("Expected forecast but got error %v.", forecastErr)
+    }
+    if len(means) != horizon {
+        t.Fatalf("Expected %d forecast steps but got %d", horizon, len(means))
+    }
+    firstMean, predictErr := model.Predict(newData)
+    if predictErr != nil {
+        t.Fatalf("Expected prediction but got error %v.", predictErr)
+    }
+    for i := range firstMean {
+        if !floatsAreClose(means[0][i], firstMean[i], floatTolerance) {
+            t.Errorf("Expected first forecast[%d] %.12f but got %.12f.", i, firstMean[i], means[0][i])
+        }
+    }
+    rows, cols := covariance.Dims()
+    if rows != len(data) || cols != len(data) {
+        t.Errorf("Expected a %dx%d covariance matrix but got %dx%d", len(data), len(data), rows, cols)
+    }
+    for i := 0; i < rows; i++ {
+        if covariance.At(i, i) <= 0 {
+            t.Errorf("Expected a positive forecast variance for variable %d but got %.12f", i, covariance.At(i, i))
+        }
+    }
+}
+
+func TestVARModelForecastNegativeHorizon(t *testing.T) {
+    data := [][]float64{{2, 7, 1, -3}, {1, -1, 3, 2}}
+    model, modelErr := NewVARModelOLS(data, 1)
+    if modelErr != nil {
+        t.Fatalf("Expected model but got error %v.", modelErr)
+    }
+    _, _, forecastErr := model.Forecast([][]float64{{5}, {-1}}, -1)
+    if forecastErr != ErrNegativeHorizon {
+        t.Errorf("Expected ErrNegativeHorizon but got %v", forecastErr)
+    }
+}